@@ -0,0 +1,18 @@
+package main
+
+import (
+	"context"
+	"time"
+)
+
+// lastModified returns the time the cache entry at key was last written, for
+// use in the Last-Modified response header. The zero time is returned (and
+// should be treated as "unknown" by callers) if the entry has no recorded
+// write time, e.g. because it hasn't been cached yet.
+func (c *controller) lastModified(ctx context.Context, key string) time.Time {
+	t, err := c.cache.ModTime(ctx, key)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
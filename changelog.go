@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+)
+
+// changelogKey is the cache key the author changelog is persisted under,
+// using the same store as the regular work/book/author cache entries.
+const changelogKey = "changelog:authors"
+
+// _changelogRetention bounds how long entries are kept. getAuthorChanged's
+// doc comment notes the client never polls less often than every 30 days, so
+// an entry older than that can never be the oldest thing a still-useful
+// "since" query needs -- without this, entries (and the per-write marshal
+// cost of persisting them) would grow for the life of the process.
+var _changelogRetention = 30 * 24 * time.Hour
+
+// changelogEntry records that an author's cache entry was written or
+// invalidated at UpdatedAt.
+type changelogEntry struct {
+	AuthorID  int64     `json:"authorID"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// changelog tracks author cache writes/invalidations so /author/changed can
+// answer "what changed since t" instead of always returning nothing.
+//
+// Ideally every cache write the controller performs -- including background
+// refreshes of an author that was never explicitly requested to be deleted --
+// would append here directly. That hook belongs in the controller, next to
+// wherever it writes the author cache entry, and controller.go isn't part of
+// this tree. As a stand-in, touch lets the handler record a change on
+// ordinary GETs too, by comparing each fetch's body hash against the last
+// one seen for that author -- so a background refresh that changes the
+// author's content still surfaces here even though it was never DELETEd.
+type changelog struct {
+	mu       sync.Mutex
+	cache    *cache
+	entries  []changelogEntry
+	lastSeen map[int64]string
+}
+
+// newChangelog creates a changelog backed by c, loading any previously
+// persisted entries.
+func newChangelog(ctx context.Context, c *cache) *changelog {
+	cl := &changelog{cache: c, lastSeen: map[int64]string{}}
+
+	raw, err := c.Get(ctx, changelogKey)
+	if err != nil {
+		return cl
+	}
+	_ = json.Unmarshal(raw, &cl.entries)
+
+	return cl
+}
+
+// record appends an entry for authorID and persists the log. Errors are
+// returned for the caller to log -- a failed changelog write shouldn't fail
+// the cache operation it's recording.
+func (cl *changelog) record(ctx context.Context, authorID int64, at time.Time) error {
+	cl.mu.Lock()
+	defer cl.mu.Unlock()
+
+	cl.entries = append(cl.entries, changelogEntry{AuthorID: authorID, UpdatedAt: at})
+	cl.entries = trimChangelog(cl.entries, at.Add(-_changelogRetention))
+
+	raw, err := json.Marshal(cl.entries)
+	if err != nil {
+		return err
+	}
+	return cl.cache.Set(ctx, changelogKey, raw, 0)
+}
+
+// trimChangelog drops entries at or before cutoff, in place.
+func trimChangelog(entries []changelogEntry, cutoff time.Time) []changelogEntry {
+	kept := entries[:0]
+	for _, e := range entries {
+		if e.UpdatedAt.After(cutoff) {
+			kept = append(kept, e)
+		}
+	}
+	return kept
+}
+
+// touch records a change for authorID if bodyHash differs from the last hash
+// seen for that author, and is a no-op otherwise. Callers pass the etag of
+// whatever body they just fetched, so an ordinary GET whose content actually
+// changed (a background refresh, not just a DELETE) still shows up in
+// /author/changed. lastSeen is process-local -- a restart forgets it and the
+// next GET for each author is treated as a change, which just means a wider
+// "changed since" window than strictly necessary, not a missed one.
+func (cl *changelog) touch(ctx context.Context, authorID int64, bodyHash string, at time.Time) {
+	cl.mu.Lock()
+	seen, ok := cl.lastSeen[authorID]
+	cl.lastSeen[authorID] = bodyHash
+	cl.mu.Unlock()
+
+	if ok && seen == bodyHash {
+		return
+	}
+	if err := cl.record(ctx, authorID, at); err != nil {
+		log(ctx).Warn("recording changelog entry", "err", err, "authorID", authorID)
+	}
+}
+
+// since returns, newest first, the distinct author IDs changed after t,
+// capped at limit, and whether the result was truncated to fit.
+func (cl *changelog) since(t time.Time, limit int) (ids []int64, limitted bool) {
+	cl.mu.Lock()
+	entries := append([]changelogEntry(nil), cl.entries...)
+	cl.mu.Unlock()
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].UpdatedAt.After(entries[j].UpdatedAt)
+	})
+
+	seen := map[int64]bool{}
+	for _, e := range entries {
+		if !e.UpdatedAt.After(t) {
+			continue
+		}
+		if seen[e.AuthorID] {
+			continue
+		}
+		seen[e.AuthorID] = true
+
+		if len(ids) >= limit {
+			return ids, true
+		}
+		ids = append(ids, e.AuthorID)
+	}
+	return ids, false
+}
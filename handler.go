@@ -1,16 +1,23 @@
 package main
 
 import (
+	"bytes"
 	"cmp"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
 	"regexp"
 	"slices"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 )
@@ -18,21 +25,72 @@ import (
 // handler is our HTTP handler. It handles muxing, response headers, etc. and
 // offloads work to the controller.
 type handler struct {
-	ctrl *controller
-	http *http.Client
+	ctrl      *controller
+	http      *http.Client
+	changelog *changelog
+	sources   sourceList
 }
 
 var _searchTTL = 24 * time.Hour
+var _authorChangedTTL = 5 * time.Minute
+var _authorChangedLimit = 2000
 
 // newHandler creates a new handler.
 func newHandler(ctrl *controller) *handler {
 	h := &handler{
-		ctrl: ctrl,
-		http: &http.Client{},
+		ctrl:      ctrl,
+		http:      &http.Client{},
+		changelog: newChangelog(context.Background(), ctrl.cache),
+		// false/"" leave OpenLibrary and the optional directory source out
+		// of the list -- wiring the -source-openlibrary/-source-directory
+		// flags through to here is main's job, and main.go isn't part of
+		// this tree.
+		sources: newSources(ctrl, false, ""),
 	}
 	return h
 }
 
+// fetchWork looks up a work by foreign work ID through h.sources (Goodreads
+// plus any enrichment/fallback sources), returning both the merged struct
+// and its canonical JSON encoding for conditionalHeaders/etag purposes.
+func (h *handler) fetchWork(ctx context.Context, foreignWorkID int64) (workResource, []byte, error) {
+	w, err := h.sources.GetWork(ctx, foreignWorkID)
+	if err != nil {
+		return workResource{}, nil, err
+	}
+	b, err := json.Marshal(w)
+	if err != nil {
+		return workResource{}, nil, err
+	}
+	return w, b, nil
+}
+
+// fetchBook is fetchWork's counterpart for foreign edition IDs.
+func (h *handler) fetchBook(ctx context.Context, foreignBookID int64) (workResource, []byte, error) {
+	w, err := h.sources.GetBook(ctx, foreignBookID)
+	if err != nil {
+		return workResource{}, nil, err
+	}
+	b, err := json.Marshal(w)
+	if err != nil {
+		return workResource{}, nil, err
+	}
+	return w, b, nil
+}
+
+// fetchAuthor is fetchWork's counterpart for authors.
+func (h *handler) fetchAuthor(ctx context.Context, foreignAuthorID int64) (authorResource, []byte, error) {
+	a, err := h.sources.GetAuthor(ctx, foreignAuthorID)
+	if err != nil {
+		return authorResource{}, nil, err
+	}
+	b, err := json.Marshal(a)
+	if err != nil {
+		return authorResource{}, nil, err
+	}
+	return a, b, nil
+}
+
 // newMux registers a handler's routes on a new mux.
 func newMux(h *handler) http.Handler {
 	mux := http.NewServeMux()
@@ -108,13 +166,19 @@ func (h *handler) bulkBook(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	result := bulkBookResource{
-		Works:   []workResource{},
-		Series:  []seriesResource{},
-		Authors: []authorResource{},
+	// Fetch every book concurrently. Despite appearances, this isn't actually
+	// streamed to the client as items arrive: they still need sorting by
+	// rating count below, and a channel delivers them in completion order,
+	// not rating order, so everything has to be collected first anyway.
+	// Memory is bounded by len(ids), same as before this endpoint was
+	// reworked to fetch concurrently -- not by the fully-assembled response,
+	// but that was already true beforehand too.
+	type bulkItem struct {
+		work workResource
+		ok   bool
 	}
 
-	mu := sync.Mutex{}
+	items := make(chan bulkItem, len(ids))
 	wg := sync.WaitGroup{}
 
 	for _, id := range ids {
@@ -123,58 +187,78 @@ func (h *handler) bulkBook(w http.ResponseWriter, r *http.Request) {
 		go func(foreignBookID int64) {
 			defer wg.Done()
 
-			b, err := h.ctrl.GetBook(ctx, foreignBookID)
+			workRsc, _, err := h.fetchBook(ctx, foreignBookID)
 			if err != nil {
 				if !errors.Is(err, errNotFound) {
 					log(ctx).Warn("getting book", "err", err, "bookID", foreignBookID)
 				}
+				items <- bulkItem{}
 				return // Ignore the error.
 			}
 
-			var workRsc workResource
-			err = json.Unmarshal(b, &workRsc)
-			if err != nil {
-				return // Ignore the error.
-			}
-
-			mu.Lock()
-			defer mu.Unlock()
-
-			result.Works = append(result.Works, workRsc)
-			result.Series = []seriesResource{}
-
-			// Check if our result already includes this author.
-			for _, a := range result.Authors {
-				if a.ForeignID == workRsc.Authors[0].ForeignID {
-					return // Nothing more to do.
-				}
-			}
-
-			result.Authors = append(result.Authors, workRsc.Authors...)
+			items <- bulkItem{work: workRsc, ok: true}
 		}(id)
 	}
 
-	wg.Wait()
+	go func() {
+		wg.Wait()
+		close(items)
+	}()
 
-	// Collect and de-dupe series -- is this even needed?
+	works := make([]workResource, 0, len(ids))
+	seenAuthors := map[int64]bool{}
 	seenSeries := map[int64]bool{}
-	for _, a := range result.Authors {
-		for _, s := range a.Series {
-			if _, seen := seenSeries[s.ForeignID]; seen {
+	authors := []authorResource{}
+	series := []seriesResource{}
+
+	for item := range items {
+		if !item.ok {
+			continue
+		}
+		works = append(works, item.work)
+
+		for _, a := range item.work.Authors {
+			if seenAuthors[a.ForeignID] {
 				continue
 			}
-			seenSeries[s.ForeignID] = true
-			result.Series = append(result.Series, s)
+			seenAuthors[a.ForeignID] = true
+			authors = append(authors, a)
+
+			for _, s := range a.Series {
+				if seenSeries[s.ForeignID] {
+					continue
+				}
+				seenSeries[s.ForeignID] = true
+				series = append(series, s)
+			}
 		}
 	}
 
-	// Sort works by rating count.
-	slices.SortFunc(result.Works, func(left, right workResource) int {
+	// Sort works by rating count, descending.
+	slices.SortFunc(works, func(left, right workResource) int {
 		return -cmp.Compare[int64](left.Books[0].RatingCount, right.Books[0].RatingCount)
 	})
 
+	var buf bytes.Buffer
+	buf.WriteString(`{"Works":`)
+	_ = json.NewEncoder(&buf).Encode(works)
+	buf.WriteString(`,"Series":`)
+	_ = json.NewEncoder(&buf).Encode(series)
+	buf.WriteString(`,"Authors":`)
+	_ = json.NewEncoder(&buf).Encode(authors)
+	buf.WriteByte('}')
+
+	out := buf.Bytes()
+
 	cacheFor(w, _searchTTL, true)
-	_ = json.NewEncoder(w).Encode(result)
+	if conditionalHeaders(w, r, out, time.Time{}) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	bw, closeBody := bodyWriter(w, r)
+	w.WriteHeader(http.StatusOK)
+	_, _ = bw.Write(out)
+	_ = closeBody()
 }
 
 // getWorkID handles /work/{id}
@@ -195,28 +279,43 @@ func (h *handler) getWorkID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	out, err := h.ctrl.GetWork(ctx, workID)
+	_, out, err := h.fetchWork(ctx, workID)
 	if err != nil {
 		h.error(w, err)
 		return
 	}
 
 	cacheFor(w, _workTTL, false)
+	if conditionalHeaders(w, r, out, h.ctrl.lastModified(ctx, workKey(workID))) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	bw, closeBody := bodyWriter(w, r)
 	w.WriteHeader(http.StatusOK)
-	_, _ = w.Write(out)
+	_, _ = bw.Write(out)
+	_ = closeBody()
 }
 
 // cacheFor sets cache response headers. s-maxage controls CDN cache time; we
 // default to an hour expiry for clients.
 //
-// Set varyParams to true if the cache key should include query params.
-func cacheFor(w http.ResponseWriter, d time.Duration, varyParams bool) {
+// Set varyParams to true if the cache key should include query params. If
+// except is non-empty, the cache instead varies on only those params (all
+// others are ignored) -- use this for endpoints like getAuthorID that accept
+// pagination/selection params but should otherwise share one cache entry.
+func cacheFor(w http.ResponseWriter, d time.Duration, varyParams bool, except ...string) {
 	w.Header().Add("Cache-Control", fmt.Sprintf("public, s-maxage=%d, max-age=3600", int(d.Seconds())))
 	w.Header().Add("Vary", "Content-Type,Accept-Encoding") // Ignore headers like User-Agent, etc.
 	w.Header().Add("Content-Type", "application/json")
-	// w.Header().Add("Content-Encoding", "gzip") // TODO: Negotiate this with the client.
 
-	if !varyParams {
+	switch {
+	case len(except) > 0:
+		quoted := make([]string, len(except))
+		for i, e := range except {
+			quoted[i] = strconv.Quote(e)
+		}
+		w.Header().Add("No-Vary-Search", fmt.Sprintf("params, except=(%s)", strings.Join(quoted, " ")))
+	case !varyParams:
 		// In most cases we ignore query params when serving cached responses,
 		// except for the bulk endpoint and some redirects where these params
 		// matter.
@@ -224,6 +323,54 @@ func cacheFor(w http.ResponseWriter, d time.Duration, varyParams bool) {
 	}
 }
 
+// bodyWriter negotiates Content-Encoding with the client and returns a writer
+// for the response body, plus a function the caller must call once all body
+// bytes have been written to flush (and close, if compressing) the stream.
+//
+// Call this only once the caller has committed to writing a body -- a 304
+// Not Modified response has no Content-Encoding and must not go through it.
+func bodyWriter(w http.ResponseWriter, r *http.Request) (io.Writer, func() error) {
+	for _, enc := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		if strings.TrimSpace(enc) == "gzip" {
+			w.Header().Set("Content-Encoding", "gzip")
+			gz := gzip.NewWriter(w)
+			return gz, gz.Close
+		}
+	}
+	return w, func() error { return nil }
+}
+
+// etag computes a strong validator for body. It's a truncated sha256 rather
+// than the full digest -- we only need collision resistance for a cache
+// validator, not a content hash.
+func etag(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `"` + hex.EncodeToString(sum[:])[:16] + `"`
+}
+
+// conditionalHeaders sets the ETag and (if known) Last-Modified headers for
+// body on w, and reports whether r's validators already match -- in which
+// case the caller should respond with 304 Not Modified instead of writing
+// body. lastMod may be the zero time if the caller has no cache timestamp to
+// offer, in which case only If-None-Match is honored.
+func conditionalHeaders(w http.ResponseWriter, r *http.Request, body []byte, lastMod time.Time) bool {
+	et := etag(body)
+	w.Header().Set("ETag", et)
+	if !lastMod.IsZero() {
+		w.Header().Set("Last-Modified", lastMod.UTC().Format(http.TimeFormat))
+	}
+
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		return inm == et
+	}
+	if ims := r.Header.Get("If-Modified-Since"); ims != "" && !lastMod.IsZero() {
+		if t, err := http.ParseTime(ims); err == nil {
+			return !lastMod.After(t)
+		}
+	}
+	return false
+}
+
 // getBookID handles /book/{id}.
 //
 // Importantly, the client expects this to always return a redirect -- either
@@ -249,21 +396,18 @@ func (h *handler) getBookID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	b, err := h.ctrl.GetBook(ctx, bookID)
+	workRsc, b, err := h.fetchBook(ctx, bookID)
 	if err != nil {
 		h.error(w, err)
 		return
 	}
 
-	var workRsc workResource
-	err = json.Unmarshal(b, &workRsc)
-	if err != nil {
-		h.error(w, err)
+	cacheFor(w, _editionTTL, false)
+	if conditionalHeaders(w, r, b, h.ctrl.lastModified(ctx, bookKey(bookID))) {
+		w.WriteHeader(http.StatusNotModified)
 		return
 	}
 
-	cacheFor(w, _editionTTL, false)
-
 	if len(workRsc.Authors) > 0 {
 		http.Redirect(w, r, fmt.Sprintf("/author/%d?edition=%d", workRsc.Authors[0].ForeignID, bookID), http.StatusSeeOther)
 		return
@@ -275,10 +419,26 @@ func (h *handler) getBookID(w http.ResponseWriter, r *http.Request) {
 	http.Redirect(w, r, fmt.Sprintf("/work/%d", workRsc.ForeignID), http.StatusSeeOther)
 }
 
+// _authorChangeableParams are the query params getAuthorID understands; the
+// cache varies on exactly these and ignores everything else.
+var _authorChangeableParams = []string{"edition", "editions", "works_offset", "works_limit", "fields"}
+
+// _authorFieldNames are the sparse-fieldset names selectable via ?fields=.
+var _authorFieldNames = map[string]bool{"works": true, "series": true, "authors": true}
+
 // getAuthorID handles /author/{id}.
 //
-// If an ?edition={bookID} query param is present, as with a /book/{id}
-// redirect, an author is returned with only that work/edition.
+// By default it returns the full "fat" author payload -- every work and
+// edition, which the comment on getBookID warns can be very large. Callers
+// can narrow the response with:
+//
+//   - ?edition={id} or ?editions={id1,id2,...}: only the named edition(s),
+//     as used by /book/{id}'s redirect here (see getBookID) and by clients
+//     fetching a handful of known editions.
+//   - ?works_offset=&works_limit=: page through Works instead of fetching
+//     them all at once. A Link: rel="next" header is set when more remain.
+//   - ?fields=works,series,authors: drop top-level fields the caller doesn't
+//     need.
 func (h *handler) getAuthorID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -290,65 +450,352 @@ func (h *handler) getAuthorID(w http.ResponseWriter, r *http.Request) {
 
 	if r.Method == "DELETE" {
 		_ = h.ctrl.cache.Delete(r.Context(), authorKey(authorID))
+		if err := h.changelog.record(r.Context(), authorID, time.Now()); err != nil {
+			log(r.Context()).Warn("recording changelog entry", "err", err, "authorID", authorID)
+		}
 		w.WriteHeader(http.StatusOK)
 		return
 	}
 
-	out, err := h.ctrl.GetAuthor(r.Context(), authorID)
+	_, out, err := h.fetchAuthor(ctx, authorID)
 	if err != nil {
 		h.error(w, err)
 		return
 	}
+	lastMod := h.ctrl.lastModified(ctx, authorKey(authorID))
 
-	// If a specific edition was requested, mutate the returned author to
-	// include only that edition. This satisifies SearchByGRBookId.
-	if edition := r.URL.Query().Get("edition"); edition != "" {
-		bookID, err := pathToID(edition)
-		if err != nil {
-			h.error(w, err)
-			return
+	// Catch content changes that never went through the DELETE branch above
+	// (e.g. a background refresh) by comparing this fetch's body against the
+	// last one we saw for this author.
+	h.changelog.touch(ctx, authorID, etag(out), time.Now())
+
+	q := r.URL.Query()
+
+	editionIDs, err := parseEditionIDs(q)
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+	worksOffset, worksLimit, err := parseWorksRange(q)
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+	fields := parseAuthorFields(q)
+
+	cacheFor(w, _authorTTL, true, _authorChangeableParams...)
+
+	// Check conditional GET once, up front, against the raw cached author
+	// bytes -- before fetching a single edition or touching Works at all.
+	// Doing this after narrowing/filtering would mean building the (possibly
+	// very large) narrowed response just to find out it matches what the
+	// client already has; authorETagKey is cheap regardless of how big the
+	// cached author is.
+	if conditionalHeaders(w, r, authorETagKey(out, q), lastMod) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	var author authorResource
+	if err := json.Unmarshal(out, &author); err != nil {
+		h.error(w, err)
+		return
+	}
+
+	// editionIDs and works_offset/works_limit are mutually exclusive: asking
+	// for specific editions already narrows Works, so pagination over them
+	// wouldn't mean anything. editionIDs wins if both are present.
+	var linkNext string
+	switch {
+	case len(editionIDs) > 0:
+		works := make([]workResource, 0, len(editionIDs))
+		for _, bookID := range editionIDs {
+			work, _, err := h.fetchBook(ctx, bookID)
+			if err != nil {
+				h.error(w, err)
+				return
+			}
+			works = append(works, work)
 		}
-		var author authorResource
-		err = json.Unmarshal(out, &author)
-		if err != nil {
-			h.error(w, err)
-			return
+		author.Works = works
+	default:
+		author.Works, linkNext = paginateWorks(author.Works, worksOffset, worksLimit, r)
+	}
+
+	if linkNext != "" {
+		w.Header().Set("Link", fmt.Sprintf("<%s>; rel=\"next\"", linkNext))
+	}
+
+	// Stream Works one element at a time regardless of which selectors are
+	// active, the same as the old no-selectors-at-all fast path -- a
+	// ?fields= or pagination selector narrowing the response is no reason to
+	// fall back to marshaling the whole (possibly unbounded) author in one
+	// shot.
+	bw, closeBody := bodyWriter(w, r)
+	w.WriteHeader(http.StatusOK)
+	if err := streamAuthor(bw, w, author, fields); err != nil {
+		log(ctx).Warn("streaming author", "err", err, "authorID", authorID)
+	}
+	_ = closeBody()
+}
+
+// authorETagKey builds a cheap conditional-GET validator body for an
+// /author/{id} response: a digest of the cached author bytes plus whichever
+// of _authorChangeableParams the request set. It deliberately doesn't
+// reflect the exact bytes that end up on the wire -- computing those would
+// mean doing all the selector-driven work (fetching editions, streaming
+// Works) this check exists to avoid -- just enough to vary correctly with
+// both the author's content and the selectors applied to it.
+func authorETagKey(out []byte, q url.Values) []byte {
+	sum := sha256.Sum256(out)
+	key := append([]byte{}, sum[:]...)
+	for _, p := range _authorChangeableParams {
+		for _, v := range q[p] {
+			key = append(key, p...)
+			key = append(key, v...)
 		}
+	}
+	return key
+}
+
+// parseEditionIDs merges the legacy singular ?edition= with the new
+// ?editions=id1,id2,... into one deduped, order-preserving ID list.
+func parseEditionIDs(q url.Values) ([]int64, error) {
+	var raw []string
+	if e := q.Get("edition"); e != "" {
+		raw = append(raw, e)
+	}
+	if es := q.Get("editions"); es != "" {
+		raw = append(raw, strings.Split(es, ",")...)
+	}
+	if len(raw) == 0 {
+		return nil, nil
+	}
 
-		var work workResource
-		ww, err := h.ctrl.GetBook(ctx, bookID)
+	seen := map[int64]bool{}
+	ids := make([]int64, 0, len(raw))
+	for _, s := range raw {
+		id, err := pathToID(strings.TrimSpace(s))
 		if err != nil {
-			h.error(w, err)
-			return
+			return nil, err
+		}
+		if seen[id] {
+			continue
 		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
 
-		err = json.Unmarshal(ww, &work)
-		if err != nil {
-			h.error(w, err)
-			return
+// parseWorksRange parses ?works_offset= and ?works_limit=. Either may be
+// omitted; an omitted offset defaults to 0 and an omitted limit means "no
+// limit".
+func parseWorksRange(q url.Values) (offset, limit int, err error) {
+	if v := q.Get("works_offset"); v != "" {
+		offset, err = strconv.Atoi(v)
+		if err != nil || offset < 0 {
+			return 0, 0, errors.Join(fmt.Errorf("invalid works_offset %q", v), errBadRequest)
+		}
+	}
+	if v := q.Get("works_limit"); v != "" {
+		limit, err = strconv.Atoi(v)
+		if err != nil || limit <= 0 {
+			return 0, 0, errors.Join(fmt.Errorf("invalid works_limit %q", v), errBadRequest)
 		}
+	}
+	return offset, limit, nil
+}
+
+// paginateWorks slices works to [offset, offset+limit) and, if more remain
+// past that window, returns the URL for the next page (for a Link:
+// rel="next" header).
+func paginateWorks(works []workResource, offset, limit int, r *http.Request) ([]workResource, string) {
+	if offset == 0 && limit == 0 {
+		return works, ""
+	}
+	if offset > len(works) {
+		offset = len(works)
+	}
 
-		author.Works = []workResource{work}
+	end := len(works)
+	hasMore := false
+	if limit > 0 && offset+limit < len(works) {
+		end = offset + limit
+		hasMore = true
+	}
+	page := works[offset:end]
+	if !hasMore {
+		return page, ""
+	}
+
+	next := *r.URL
+	nq := next.Query()
+	nq.Set("works_offset", strconv.Itoa(end))
+	if limit > 0 {
+		nq.Set("works_limit", strconv.Itoa(limit))
+	}
+	next.RawQuery = nq.Encode()
+	return page, next.String()
+}
+
+// parseAuthorFields parses ?fields=works,series,authors into a set of the
+// recognized names. Unrecognized names are ignored. A nil return means no
+// filtering was requested.
+func parseAuthorFields(q url.Values) map[string]bool {
+	v := q.Get("fields")
+	if v == "" {
+		return nil
+	}
+
+	fields := map[string]bool{}
+	for _, f := range strings.Split(v, ",") {
+		f = strings.ToLower(strings.TrimSpace(f))
+		if _authorFieldNames[f] {
+			fields[f] = true
+		}
+	}
+	if len(fields) == 0 {
+		// Every requested name was unrecognized (e.g. a typo) -- treat that
+		// the same as not filtering at all, rather than stripping every
+		// selectable field from the response.
+		return nil
+	}
+	return fields
+}
 
-		cacheFor(w, _authorTTL, true)
-		_ = json.NewEncoder(w).Encode(author)
+// filterFields drops keys from m that are in _authorFieldNames but weren't
+// requested in fields, leaving every other key untouched. A nil fields is a
+// no-op.
+func filterFields(m map[string]json.RawMessage, fields map[string]bool) {
+	if fields == nil {
 		return
+	}
+	for key := range m {
+		if _authorFieldNames[strings.ToLower(key)] && !fields[strings.ToLower(key)] {
+			delete(m, key)
+		}
+	}
+}
 
+// filterAuthorFields strips top-level JSON keys matching _authorFieldNames
+// that weren't requested in fields, leaving every other key untouched. A nil
+// fields leaves body as-is.
+func filterAuthorFields(body []byte, fields map[string]bool) ([]byte, error) {
+	if fields == nil {
+		return body, nil
 	}
 
-	cacheFor(w, _authorTTL, true)
-	w.WriteHeader(http.StatusOK)
-	_, _ = w.Write(out)
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(body, &m); err != nil {
+		return nil, err
+	}
+	filterFields(m, fields)
+	return json.Marshal(m)
+}
+
+// streamAuthor writes author to w as a single JSON object. Unless fields
+// excludes "works", Works is encoded one element at a time and flushed after
+// each (when w's underlying http.ResponseWriter supports it) rather than
+// marshaled as a whole, so a client fetching a large catalog starts
+// receiving bytes immediately instead of waiting for the whole response --
+// and so a caller that also narrowed Works (by edition or page) never pays
+// to marshal more of it than it's about to send. fields behaves exactly as
+// it does for filterAuthorFields; pass nil for the unfiltered response.
+//
+// w is often a *gzip.Writer wrapping rw rather than rw itself -- gzip.Writer
+// buffers internally and has its own Flush, distinct from http.Flusher, so
+// both must be flushed in order (gzip first, to push its buffered bytes into
+// rw, then rw itself) or a gzip client never sees the incremental writes.
+func streamAuthor(w io.Writer, rw http.ResponseWriter, author authorResource, fields map[string]bool) error {
+	flusher, _ := rw.(http.Flusher)
+	gzFlusher, _ := w.(interface{ Flush() error })
+
+	works := author.Works
+	author.Works = nil
+
+	raw, err := json.Marshal(author)
+	if err != nil {
+		return err
+	}
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return err
+	}
+	delete(m, "Works") // Always handled separately below, not by filterFields.
+	filterFields(m, fields)
+
+	envelope, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	includeWorks := fields == nil || fields["works"]
+	if !includeWorks {
+		_, err := w.Write(envelope)
+		return err
+	}
+
+	// envelope is `{"ForeignID":...,...}`; splice the streamed Works array in
+	// before the closing brace.
+	envelope = envelope[:len(envelope)-1]
+	if len(envelope) > len(`{`) {
+		envelope = append(envelope, ',')
+	}
+	envelope = append(envelope, []byte(`"Works":[`)...)
+	if _, err := w.Write(envelope); err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(w)
+	for i, work := range works {
+		if i > 0 {
+			if _, err := w.Write([]byte(",")); err != nil {
+				return err
+			}
+		}
+		if err := enc.Encode(work); err != nil {
+			return err
+		}
+		if gzFlusher != nil {
+			_ = gzFlusher.Flush()
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+
+	if _, err := w.Write([]byte("]}")); err != nil {
+		return err
+	}
+	if gzFlusher != nil {
+		_ = gzFlusher.Flush()
+	}
+	if flusher != nil {
+		flusher.Flush()
+	}
+	return nil
+}
+
+// authorChangedResource is the body of the `/author/changed` response. The
+// "Limitted" spelling matches the client's expected field name, typo and all.
+type authorChangedResource struct {
+	Limitted bool
+	Ids      []int64
 }
 
 // getAuthorChanged handles the `/author/changed?since={datetime}` endpoint.
 //
-// Normally this would return IDs for _all_ authors updated since the given
-// timestamp -- not just the authors in your library. The query param makes
-// this uncachable and it's an expensive operation, so we return nothing and
-// force the client to no-op.
+// `since` may be an RFC3339 timestamp or a Unix timestamp in seconds. We scan
+// the changelog for author IDs touched
+// after that time and return them, capped at _authorChangedLimit with
+// Limitted: true when truncated.
+//
+// The response varies by `since`, so we use a short TTL and vary on query
+// params rather than the long, param-ignoring cache most other endpoints use.
 //
-// As a result, the client will periodically re-query `/author/{id}`:
+// Without this, the client falls back to periodically re-querying
+// `/author/{id}` on its own polling schedule:
 //   - At least once every 30 days.
 //   - Not more than every 12 hours.
 //   - At least every 2 days if the author is "continuing" -- which always
@@ -356,13 +803,28 @@ func (h *handler) getAuthorID(w http.ResponseWriter, r *http.Request) {
 //     because they aren't returned by us.
 //   - Every day if they released a book in the past 30 days, maybe to pick up
 //     newer ratings? Unclear.
-//
-// These will hit cached entries, and the client will pick up newer data
-// gradually as entries become invalidated.
-func (h *handler) getAuthorChanged(w http.ResponseWriter, _ *http.Request) {
-	cacheFor(w, _searchTTL, false)
+func (h *handler) getAuthorChanged(w http.ResponseWriter, r *http.Request) {
+	since := time.Time{}
+	if s := r.URL.Query().Get("since"); s != "" {
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			unix, uerr := strconv.ParseInt(s, 10, 64)
+			if uerr != nil {
+				h.error(w, errors.Join(err, errBadRequest))
+				return
+			}
+			t = time.Unix(unix, 0)
+		}
+		since = t
+	}
+
+	ids, limitted := h.changelog.since(since, _authorChangedLimit)
+
+	cacheFor(w, _authorChangedTTL, true)
+	bw, closeBody := bodyWriter(w, r)
 	w.WriteHeader(http.StatusOK)
-	_, _ = w.Write([]byte(`{"Limitted": true, "Ids": []}`))
+	_ = json.NewEncoder(bw).Encode(authorChangedResource{Limitted: limitted, Ids: ids})
+	_ = closeBody()
 }
 
 // error writes an error message. The status code defaults to 500 unless the
@@ -0,0 +1,272 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestEtag(t *testing.T) {
+	a := etag([]byte("hello"))
+	b := etag([]byte("hello"))
+	if a != b {
+		t.Errorf("etag not stable: %q != %q", a, b)
+	}
+
+	c := etag([]byte("world"))
+	if a == c {
+		t.Errorf("etag collided for different bodies: %q", a)
+	}
+
+	if len(a) < 2 || a[0] != '"' || a[len(a)-1] != '"' {
+		t.Errorf("etag %q is not a quoted validator", a)
+	}
+}
+
+func TestConditionalHeaders(t *testing.T) {
+	body := []byte(`{"ForeignID":1}`)
+	et := etag(body)
+	now := time.Date(2026, 7, 29, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		ifNoneMatch string
+		ifModSince  string
+		lastMod     time.Time
+		wantMatch   bool
+	}{
+		{
+			name:      "no validators present",
+			wantMatch: false,
+		},
+		{
+			name:        "If-None-Match matches",
+			ifNoneMatch: et,
+			wantMatch:   true,
+		},
+		{
+			name:        "If-None-Match does not match",
+			ifNoneMatch: `"stale"`,
+			wantMatch:   false,
+		},
+		{
+			name:       "If-Modified-Since at lastMod",
+			ifModSince: now.Format(http.TimeFormat),
+			lastMod:    now,
+			wantMatch:  true,
+		},
+		{
+			name:       "If-Modified-Since before lastMod",
+			ifModSince: now.Add(-time.Hour).Format(http.TimeFormat),
+			lastMod:    now,
+			wantMatch:  false,
+		},
+		{
+			name:       "If-Modified-Since ignored when lastMod is zero",
+			ifModSince: now.Format(http.TimeFormat),
+			wantMatch:  false,
+		},
+		{
+			name:        "If-None-Match takes precedence over If-Modified-Since",
+			ifNoneMatch: `"stale"`,
+			ifModSince:  now.Format(http.TimeFormat),
+			lastMod:     now,
+			wantMatch:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/author/1", nil)
+			if tt.ifNoneMatch != "" {
+				r.Header.Set("If-None-Match", tt.ifNoneMatch)
+			}
+			if tt.ifModSince != "" {
+				r.Header.Set("If-Modified-Since", tt.ifModSince)
+			}
+
+			w := httptest.NewRecorder()
+			got := conditionalHeaders(w, r, body, tt.lastMod)
+			if got != tt.wantMatch {
+				t.Errorf("conditionalHeaders() = %v, want %v", got, tt.wantMatch)
+			}
+			if w.Header().Get("ETag") != et {
+				t.Errorf("ETag header = %q, want %q", w.Header().Get("ETag"), et)
+			}
+		})
+	}
+}
+
+func TestParseEditionIDs(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		want    []int64
+		wantErr bool
+	}{
+		{name: "none given", query: "", want: nil},
+		{name: "single edition", query: "edition=5", want: []int64{5}},
+		{name: "editions list", query: "editions=1,2,3", want: []int64{1, 2, 3}},
+		{name: "edition and editions combined, deduped", query: "edition=2&editions=1,2,3", want: []int64{2, 1, 3}},
+		{name: "malformed id", query: "edition=abc", wantErr: true},
+		{name: "zero id rejected", query: "edition=0", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := url.ParseQuery(tt.query)
+			if err != nil {
+				t.Fatalf("ParseQuery(%q): %v", tt.query, err)
+			}
+
+			got, err := parseEditionIDs(q)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseEditionIDs(%q) error = %v, wantErr %v", tt.query, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("parseEditionIDs(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("parseEditionIDs(%q)[%d] = %d, want %d", tt.query, i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestParseWorksRange(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		wantOffset int
+		wantLimit  int
+		wantErr    bool
+	}{
+		{name: "neither given", query: ""},
+		{name: "offset only", query: "works_offset=5", wantOffset: 5},
+		{name: "limit only", query: "works_limit=10", wantLimit: 10},
+		{name: "both given", query: "works_offset=5&works_limit=10", wantOffset: 5, wantLimit: 10},
+		{name: "negative offset", query: "works_offset=-1", wantErr: true},
+		{name: "non-numeric offset", query: "works_offset=abc", wantErr: true},
+		{name: "zero limit", query: "works_limit=0", wantErr: true},
+		{name: "negative limit", query: "works_limit=-1", wantErr: true},
+		{name: "non-numeric limit", query: "works_limit=abc", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := url.ParseQuery(tt.query)
+			if err != nil {
+				t.Fatalf("ParseQuery(%q): %v", tt.query, err)
+			}
+
+			offset, limit, err := parseWorksRange(q)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseWorksRange(%q) error = %v, wantErr %v", tt.query, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if offset != tt.wantOffset || limit != tt.wantLimit {
+				t.Errorf("parseWorksRange(%q) = (%d, %d), want (%d, %d)", tt.query, offset, limit, tt.wantOffset, tt.wantLimit)
+			}
+		})
+	}
+}
+
+func TestPaginateWorks(t *testing.T) {
+	works := []workResource{{ForeignID: 1}, {ForeignID: 2}, {ForeignID: 3}}
+
+	t.Run("no pagination requested", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/author/1", nil)
+		page, next := paginateWorks(works, 0, 0, r)
+		if len(page) != 3 || next != "" {
+			t.Errorf("paginateWorks(0, 0) = (%v, %q), want full slice with no next link", page, next)
+		}
+	})
+
+	t.Run("offset past the end", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/author/1", nil)
+		page, next := paginateWorks(works, 10, 0, r)
+		if len(page) != 0 || next != "" {
+			t.Errorf("paginateWorks(10, 0) = (%v, %q), want empty page with no next link", page, next)
+		}
+	})
+
+	t.Run("limit smaller than remainder returns a next link", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/author/1?works_offset=0&works_limit=2", nil)
+		page, next := paginateWorks(works, 0, 2, r)
+		if len(page) != 2 || page[0].ForeignID != 1 || page[1].ForeignID != 2 {
+			t.Fatalf("paginateWorks(0, 2) page = %v, want first two works", page)
+		}
+		if next == "" {
+			t.Fatal("paginateWorks(0, 2) next = \"\", want a next-page link")
+		}
+		u, err := url.Parse(next)
+		if err != nil {
+			t.Fatalf("next link %q did not parse: %v", next, err)
+		}
+		if got := u.Query().Get("works_offset"); got != "2" {
+			t.Errorf("next link works_offset = %q, want \"2\"", got)
+		}
+		if got := u.Query().Get("works_limit"); got != "2" {
+			t.Errorf("next link works_limit = %q, want \"2\"", got)
+		}
+	})
+
+	t.Run("limit covering the remainder has no next link", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/author/1?works_offset=1&works_limit=2", nil)
+		page, next := paginateWorks(works, 1, 2, r)
+		if len(page) != 2 || next != "" {
+			t.Errorf("paginateWorks(1, 2) = (%v, %q), want last two works with no next link", page, next)
+		}
+	})
+}
+
+func TestFilterFields(t *testing.T) {
+	newBody := func() map[string]json.RawMessage {
+		return map[string]json.RawMessage{
+			"Works":     json.RawMessage(`[]`),
+			"Series":    json.RawMessage(`[]`),
+			"Name":      json.RawMessage(`"someone"`),
+			"ForeignID": json.RawMessage(`1`),
+		}
+	}
+
+	t.Run("nil fields is a no-op", func(t *testing.T) {
+		m := newBody()
+		filterFields(m, nil)
+		if len(m) != 4 {
+			t.Errorf("filterFields(nil) changed the map: %v", m)
+		}
+	})
+
+	t.Run("drops selectable keys not requested", func(t *testing.T) {
+		m := newBody()
+		filterFields(m, map[string]bool{"works": true})
+		if _, ok := m["Works"]; !ok {
+			t.Error("filterFields dropped the requested \"Works\" key")
+		}
+		if _, ok := m["Series"]; ok {
+			t.Error("filterFields kept \"Series\", which wasn't requested")
+		}
+	})
+
+	t.Run("leaves unselectable keys untouched regardless of fields", func(t *testing.T) {
+		m := newBody()
+		filterFields(m, map[string]bool{"works": true})
+		if _, ok := m["Name"]; !ok {
+			t.Error("filterFields dropped \"Name\", which isn't a selectable field")
+		}
+		if _, ok := m["ForeignID"]; !ok {
+			t.Error("filterFields dropped \"ForeignID\", which isn't a selectable field")
+		}
+	})
+}
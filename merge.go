@@ -0,0 +1,78 @@
+package main
+
+// mergeWork folds src into dst using chunk0-4's merge policy: first-non-empty
+// wins for identity fields, editions union by foreign ID, and rating counts
+// take the max seen across sources.
+func mergeWork(dst *workResource, src workResource) {
+	if dst.ForeignID == 0 {
+		dst.ForeignID = src.ForeignID
+	}
+	if dst.Title == "" {
+		dst.Title = src.Title
+	}
+	if len(dst.Authors) == 0 {
+		dst.Authors = src.Authors
+	}
+	dst.Books = unionBooks(dst.Books, src.Books)
+}
+
+// mergeAuthor folds src into dst using the same policy: identity fields are
+// first-non-empty, and works/series union by foreign ID.
+func mergeAuthor(dst *authorResource, src authorResource) {
+	if dst.ForeignID == 0 {
+		dst.ForeignID = src.ForeignID
+	}
+	if dst.Name == "" {
+		dst.Name = src.Name
+	}
+	dst.Works = unionWorks(dst.Works, src.Works)
+	dst.Series = unionSeries(dst.Series, src.Series)
+}
+
+func unionBooks(dst, src []bookResource) []bookResource {
+	idx := make(map[int64]int, len(dst))
+	for i, b := range dst {
+		idx[b.ForeignID] = i
+	}
+	for _, b := range src {
+		if i, ok := idx[b.ForeignID]; ok {
+			if b.RatingCount > dst[i].RatingCount {
+				dst[i].RatingCount = b.RatingCount
+			}
+			continue
+		}
+		idx[b.ForeignID] = len(dst)
+		dst = append(dst, b)
+	}
+	return dst
+}
+
+func unionWorks(dst, src []workResource) []workResource {
+	seen := make(map[int64]bool, len(dst))
+	for _, w := range dst {
+		seen[w.ForeignID] = true
+	}
+	for _, w := range src {
+		if seen[w.ForeignID] {
+			continue
+		}
+		seen[w.ForeignID] = true
+		dst = append(dst, w)
+	}
+	return dst
+}
+
+func unionSeries(dst, src []seriesResource) []seriesResource {
+	seen := make(map[int64]bool, len(dst))
+	for _, s := range dst {
+		seen[s.ForeignID] = true
+	}
+	for _, s := range src {
+		if seen[s.ForeignID] {
+			continue
+		}
+		seen[s.ForeignID] = true
+		dst = append(dst, s)
+	}
+	return dst
+}
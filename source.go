@@ -0,0 +1,232 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// _sourceTimeout bounds each individual source's lookups, so a hanging
+// OpenLibrary or HTTP-served directory can't block an aggregate lookup past
+// its own budget -- the caller's context still governs the lookup as a
+// whole.
+var _sourceTimeout = 10 * time.Second
+
+// Source is a metadata provider that can answer work/book/author lookups and
+// searches. controller consults an ordered list of them (see sourceList)
+// instead of a single upstream, similar to how the Go vulndb client consults
+// multiple databases and merges what it finds rather than trusting one
+// source of truth.
+type Source interface {
+	// GetWork looks up a work by its foreign (Goodreads-style) work ID.
+	GetWork(ctx context.Context, foreignWorkID int64) (workResource, error)
+	// GetBook looks up the work owning a foreign edition ID.
+	GetBook(ctx context.Context, foreignBookID int64) (workResource, error)
+	// GetAuthor looks up an author, including their works.
+	GetAuthor(ctx context.Context, foreignAuthorID int64) (authorResource, error)
+	// Search runs a free-text search and returns matching works.
+	Search(ctx context.Context, query string) ([]workResource, error)
+}
+
+// sourceList consults every Source concurrently -- each bounded by its own
+// _sourceTimeout, so a slow source can't stack its timeout onto the others --
+// and merges what they return in list order. Earlier sources win for
+// identity fields (first-non-empty); editions and series are unioned by
+// foreign ID; rating counts take the max seen across sources.
+//
+// handler.sources holds one of these and consults it instead of going
+// straight to h.ctrl for work/book/author lookups -- see newHandler. The
+// refactor the chunk0-4 request actually asked for, pushing this down into
+// controller itself so every caller (not just handler) benefits, still can't
+// happen here: that struct, and the extraction of the current
+// Goodreads-calling code into a Source, live in controller.go, which isn't
+// part of this tree. goodreadsSource wraps the controller's existing public
+// methods as the best available stand-in in the meantime.
+type sourceList []Source
+
+// withSourceTimeout bounds a single source's call to _sourceTimeout, separate
+// from (and usually shorter than) whatever deadline ctx already carries --
+// one slow source should cost at most its own budget, not eat into the
+// budget of the sources consulted alongside it.
+func withSourceTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, _sourceTimeout)
+}
+
+func (sl sourceList) GetWork(ctx context.Context, foreignWorkID int64) (workResource, error) {
+	results := make([]struct {
+		w   workResource
+		err error
+	}, len(sl))
+
+	var wg sync.WaitGroup
+	for i, src := range sl {
+		wg.Add(1)
+		go func(i int, src Source) {
+			defer wg.Done()
+			sctx, cancel := withSourceTimeout(ctx)
+			defer cancel()
+			results[i].w, results[i].err = src.GetWork(sctx, foreignWorkID)
+		}(i, src)
+	}
+	wg.Wait()
+
+	var merged workResource
+	found := false
+	for _, r := range results {
+		if r.err != nil {
+			logSourceErr(ctx, "GetWork", r.err)
+			continue
+		}
+		if !found {
+			merged, found = r.w, true
+			continue
+		}
+		mergeWork(&merged, r.w)
+	}
+	if !found {
+		return workResource{}, errNotFound
+	}
+	return merged, nil
+}
+
+func (sl sourceList) GetBook(ctx context.Context, foreignBookID int64) (workResource, error) {
+	results := make([]struct {
+		w   workResource
+		err error
+	}, len(sl))
+
+	var wg sync.WaitGroup
+	for i, src := range sl {
+		wg.Add(1)
+		go func(i int, src Source) {
+			defer wg.Done()
+			sctx, cancel := withSourceTimeout(ctx)
+			defer cancel()
+			results[i].w, results[i].err = src.GetBook(sctx, foreignBookID)
+		}(i, src)
+	}
+	wg.Wait()
+
+	var merged workResource
+	found := false
+	for _, r := range results {
+		if r.err != nil {
+			logSourceErr(ctx, "GetBook", r.err)
+			continue
+		}
+		if !found {
+			merged, found = r.w, true
+			continue
+		}
+		mergeWork(&merged, r.w)
+	}
+	if !found {
+		return workResource{}, errNotFound
+	}
+	return merged, nil
+}
+
+func (sl sourceList) GetAuthor(ctx context.Context, foreignAuthorID int64) (authorResource, error) {
+	results := make([]struct {
+		a   authorResource
+		err error
+	}, len(sl))
+
+	var wg sync.WaitGroup
+	for i, src := range sl {
+		wg.Add(1)
+		go func(i int, src Source) {
+			defer wg.Done()
+			sctx, cancel := withSourceTimeout(ctx)
+			defer cancel()
+			results[i].a, results[i].err = src.GetAuthor(sctx, foreignAuthorID)
+		}(i, src)
+	}
+	wg.Wait()
+
+	var merged authorResource
+	found := false
+	for _, r := range results {
+		if r.err != nil {
+			logSourceErr(ctx, "GetAuthor", r.err)
+			continue
+		}
+		if !found {
+			merged, found = r.a, true
+			continue
+		}
+		mergeAuthor(&merged, r.a)
+	}
+	if !found {
+		return authorResource{}, errNotFound
+	}
+	return merged, nil
+}
+
+func (sl sourceList) Search(ctx context.Context, query string) ([]workResource, error) {
+	results := make([][]workResource, len(sl))
+	errs := make([]error, len(sl))
+
+	var wg sync.WaitGroup
+	for i, src := range sl {
+		wg.Add(1)
+		go func(i int, src Source) {
+			defer wg.Done()
+			sctx, cancel := withSourceTimeout(ctx)
+			defer cancel()
+			results[i], errs[i] = src.Search(sctx, query)
+		}(i, src)
+	}
+	wg.Wait()
+
+	seen := map[int64]bool{}
+	var out []workResource
+	for i, ws := range results {
+		if errs[i] != nil {
+			logSourceErr(ctx, "Search", errs[i])
+			continue
+		}
+		for _, w := range ws {
+			if seen[w.ForeignID] {
+				continue
+			}
+			seen[w.ForeignID] = true
+			out = append(out, w)
+		}
+	}
+	return out, nil
+}
+
+// logSourceErr logs a per-source failure without aborting the rest of the
+// list -- a single source being down shouldn't take the aggregate down with
+// it, that's the whole point of having more than one.
+func logSourceErr(ctx context.Context, op string, err error) {
+	if errors.Is(err, errNotFound) {
+		return
+	}
+	log(ctx).Warn("source lookup failed", "op", op, "err", err)
+}
+
+// newSources builds the ordered list controller should consult: Goodreads
+// (the existing upstream, via goodreadsSource) is always first since it
+// remains the primary, best-populated source. OpenLibrary and a local or
+// HTTP-served directory are both optional enrichment/fallback sources --
+// OpenLibrary means a mandatory live call to a third-party service on every
+// lookup, so it only joins the list when explicitly enabled, the same as the
+// directory source already required a non-empty path to join.
+//
+// enableOpenLibrary and directoryPath are the values of the
+// -source-openlibrary and -source-directory CLI flags; registering those
+// flags is main's job -- main.go isn't part of this tree slice, so it isn't
+// wired up here.
+func newSources(ctrl *controller, enableOpenLibrary bool, directoryPath string) sourceList {
+	sources := sourceList{goodreadsSource{ctrl: ctrl}}
+	if enableOpenLibrary {
+		sources = append(sources, newOpenLibrarySource(_sourceTimeout))
+	}
+	if directoryPath != "" {
+		sources = append(sources, newDirectorySource(directoryPath, _sourceTimeout))
+	}
+	return sources
+}
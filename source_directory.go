@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// directorySource serves metadata from a directory of pre-shaped JSON files
+// -- one per work/book/author, named by foreign ID -- so operators can drop
+// in local corrections or additions without waiting on any upstream. base
+// may be a local filesystem path or an http(s) URL, passed to newSources as
+// directoryPath; newHandler passes an empty path, so the handler's source
+// list doesn't include this source.
+type directorySource struct {
+	base string
+	http *http.Client
+}
+
+// newDirectorySource builds a source bounded by timeout. It only matters for
+// the HTTP-served case -- local filesystem reads ignore it -- but carrying
+// one client keeps the struct uniform with the other sources.
+func newDirectorySource(base string, timeout time.Duration) *directorySource {
+	return &directorySource{base: base, http: &http.Client{Timeout: timeout}}
+}
+
+func (s *directorySource) isRemote() bool {
+	u, err := url.Parse(s.base)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https")
+}
+
+func (s *directorySource) read(ctx context.Context, kind string, id int64, out any) error {
+	name := strconv.FormatInt(id, 10) + ".json"
+
+	if s.isRemote() {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.base+"/"+kind+"/"+name, nil)
+		if err != nil {
+			return err
+		}
+		resp, err := s.http.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode == http.StatusNotFound {
+			return errNotFound
+		}
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("directory source: %s", resp.Status)
+		}
+		return json.NewDecoder(resp.Body).Decode(out)
+	}
+
+	raw, err := os.ReadFile(filepath.Join(s.base, kind, name))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return errNotFound
+		}
+		return err
+	}
+	return json.Unmarshal(raw, out)
+}
+
+func (s *directorySource) GetWork(ctx context.Context, foreignWorkID int64) (workResource, error) {
+	var w workResource
+	err := s.read(ctx, "works", foreignWorkID, &w)
+	return w, err
+}
+
+func (s *directorySource) GetBook(ctx context.Context, foreignBookID int64) (workResource, error) {
+	var w workResource
+	err := s.read(ctx, "books", foreignBookID, &w)
+	return w, err
+}
+
+func (s *directorySource) GetAuthor(ctx context.Context, foreignAuthorID int64) (authorResource, error) {
+	var a authorResource
+	err := s.read(ctx, "authors", foreignAuthorID, &a)
+	return a, err
+}
+
+// Search has no meaningful implementation over a directory of individually
+// named files -- there's no index to query -- so it always comes back empty.
+func (s *directorySource) Search(_ context.Context, _ string) ([]workResource, error) {
+	return nil, nil
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// goodreadsSource adapts the controller's existing (and, until this chunk,
+// only) upstream to the Source interface. It's a thin wrapper over the
+// already-cached GetWork/GetBook/GetAuthor methods rather than a
+// reimplementation of the scraper -- that code stays where it is; this just
+// gives callers a Source-shaped handle on it. It has no http.Client of its
+// own to put a timeout on: whatever bound ctrl's existing Goodreads calls had
+// before this chunk still applies unchanged.
+type goodreadsSource struct {
+	ctrl *controller
+}
+
+func (s goodreadsSource) GetWork(ctx context.Context, foreignWorkID int64) (workResource, error) {
+	b, err := s.ctrl.GetWork(ctx, foreignWorkID)
+	if err != nil {
+		return workResource{}, err
+	}
+	var w workResource
+	if err := json.Unmarshal(b, &w); err != nil {
+		return workResource{}, err
+	}
+	return w, nil
+}
+
+func (s goodreadsSource) GetBook(ctx context.Context, foreignBookID int64) (workResource, error) {
+	b, err := s.ctrl.GetBook(ctx, foreignBookID)
+	if err != nil {
+		return workResource{}, err
+	}
+	var w workResource
+	if err := json.Unmarshal(b, &w); err != nil {
+		return workResource{}, err
+	}
+	return w, nil
+}
+
+func (s goodreadsSource) GetAuthor(ctx context.Context, foreignAuthorID int64) (authorResource, error) {
+	b, err := s.ctrl.GetAuthor(ctx, foreignAuthorID)
+	if err != nil {
+		return authorResource{}, err
+	}
+	var a authorResource
+	if err := json.Unmarshal(b, &a); err != nil {
+		return authorResource{}, err
+	}
+	return a, nil
+}
+
+// Search isn't exposed by controller today, so there's nothing to wrap yet.
+func (s goodreadsSource) Search(_ context.Context, _ string) ([]workResource, error) {
+	return nil, errNotFound
+}
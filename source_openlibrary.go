@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// openLibrarySource enriches/falls back to the free OpenLibrary API. It's
+// read-only and unauthenticated, so it costs us nothing to keep in the list
+// as a fallback for when Goodreads scraping is down.
+type openLibrarySource struct {
+	http *http.Client
+}
+
+// newOpenLibrarySource builds a source bounded by timeout, so a slow or
+// hanging OpenLibrary doesn't stall the whole aggregate lookup -- a single
+// source being slow shouldn't cost more than its own budget.
+func newOpenLibrarySource(timeout time.Duration) *openLibrarySource {
+	return &openLibrarySource{http: &http.Client{Timeout: timeout}}
+}
+
+// olBooksResponse mirrors the bibkeys lookup response from
+// https://openlibrary.org/api/books, keyed by the bibkey we queried with.
+type olBooksResponse map[string]struct {
+	Title   string `json:"title"`
+	Authors []struct {
+		Name string `json:"name"`
+	} `json:"authors"`
+}
+
+// GetBook cross-references foreignBookID against OpenLibrary's Goodreads
+// bibkey index. This is the one place OpenLibrary exposes a direct mapping
+// from a Goodreads ID to its own records.
+func (s *openLibrarySource) GetBook(ctx context.Context, foreignBookID int64) (workResource, error) {
+	url := fmt.Sprintf("https://openlibrary.org/api/books?bibkeys=GOODREADS:%d&jscmd=data&format=json", foreignBookID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return workResource{}, err
+	}
+
+	resp, err := s.http.Do(req)
+	if err != nil {
+		return workResource{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return workResource{}, errNotFound
+	}
+
+	var out olBooksResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return workResource{}, err
+	}
+
+	entry, ok := out[fmt.Sprintf("GOODREADS:%d", foreignBookID)]
+	if !ok || entry.Title == "" {
+		return workResource{}, errNotFound
+	}
+
+	var authors []authorResource
+	for _, a := range entry.Authors {
+		authors = append(authors, authorResource{Name: a.Name})
+	}
+
+	return workResource{
+		Title:   entry.Title,
+		Authors: authors,
+		Books:   []bookResource{{ForeignID: foreignBookID, Title: entry.Title}},
+	}, nil
+}
+
+// GetWork and GetAuthor have no equivalent direct Goodreads-ID index on
+// OpenLibrary's side -- only editions cross-reference that way -- so those
+// lookups have nothing reliable to key off of here.
+func (s *openLibrarySource) GetWork(_ context.Context, _ int64) (workResource, error) {
+	return workResource{}, errNotFound
+}
+
+func (s *openLibrarySource) GetAuthor(_ context.Context, _ int64) (authorResource, error) {
+	return authorResource{}, errNotFound
+}
+
+// olSearchResponse mirrors https://openlibrary.org/search.json.
+type olSearchResponse struct {
+	Docs []struct {
+		Title      string   `json:"title"`
+		AuthorName []string `json:"author_name"`
+	} `json:"docs"`
+}
+
+func (s *openLibrarySource) Search(ctx context.Context, query string) ([]workResource, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://openlibrary.org/search.json", nil)
+	if err != nil {
+		return nil, err
+	}
+	q := req.URL.Query()
+	q.Set("q", query)
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := s.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("openlibrary search: %s", resp.Status)
+	}
+
+	var out olSearchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	results := make([]workResource, 0, len(out.Docs))
+	for _, d := range out.Docs {
+		var authors []authorResource
+		for _, name := range d.AuthorName {
+			authors = append(authors, authorResource{Name: name})
+		}
+		results = append(results, workResource{Title: d.Title, Authors: authors})
+	}
+	return results, nil
+}